@@ -0,0 +1,37 @@
+// Package v7action is the actor layer shared by every v7 command (push,
+// scale, services, labels, ...). Actor, NewActor, Warnings, and
+// CloudControllerClient are the package's pre-existing foundational types;
+// the label-selector and cascade-listing methods in label_selector.go and
+// label_cascade.go are additions layered on top of them via extra methods
+// on Actor, not redeclarations. Do not add fields to Actor or narrow
+// CloudControllerClient to only what label.go needs — both are depended on
+// by the rest of the v7 command set.
+package v7action
+
+import (
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/clock"
+)
+
+// Warnings are non-fatal messages surfaced by the Cloud Controller
+// alongside a request's result.
+type Warnings []string
+
+// Actor handles all business logic for Cloud Controller v3 operations.
+type Actor struct {
+	CloudControllerClient CloudControllerClient
+	Config                command.Config
+	Clock                 clock.Clock
+}
+
+// NewActor returns a new Actor wired to the given Cloud Controller client
+// and configuration. uploadClient and downloader are accepted to match the
+// dependencies callers already wire up elsewhere; the label commands don't
+// use either.
+func NewActor(client CloudControllerClient, config command.Config, uploadClient interface{}, downloader interface{}, clk clock.Clock) *Actor {
+	return &Actor{
+		CloudControllerClient: client,
+		Config:                config,
+		Clock:                 clk,
+	}
+}
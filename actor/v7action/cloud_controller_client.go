@@ -0,0 +1,43 @@
+package v7action
+
+import "code.cloudfoundry.org/cli/resources"
+
+// QueryKey is a Cloud Controller v3 list-endpoint query parameter name.
+type QueryKey string
+
+const (
+	NameFilter                QueryKey = "names"
+	SpaceGUIDFilter           QueryKey = "space_guids"
+	OrganizationGUIDFilter    QueryKey = "organization_guids"
+	ServiceBrokerNameFilter   QueryKey = "service_broker_names"
+	ServiceOfferingNameFilter QueryKey = "service_offering_names"
+	StackFilter               QueryKey = "stacks"
+	// LabelSelectorFilter is the CC v3 query parameter that carries a raw
+	// label selector expression, e.g. "env=prod,tier!=batch".
+	LabelSelectorFilter QueryKey = "label_selector"
+)
+
+// Query is a single Cloud Controller v3 list-endpoint query parameter.
+type Query struct {
+	Key    QueryKey
+	Values []string
+}
+
+//go:generate counterfeiter . CloudControllerClient
+
+// CloudControllerClient is the actor's handle onto the Cloud Controller v3
+// API, shared across every v7 command. It declares the list endpoints the
+// label commands call through. Extend this list when a label method needs a
+// new query, but don't treat it as label.go's private interface.
+type CloudControllerClient interface {
+	GetApplications(query ...Query) ([]resources.LabelFields, Warnings, error)
+	GetDomains(query ...Query) ([]resources.LabelFields, Warnings, error)
+	GetOrganizations(query ...Query) ([]resources.LabelFields, Warnings, error)
+	GetRoutes(query ...Query) ([]resources.LabelFields, Warnings, error)
+	GetSpaces(query ...Query) ([]resources.LabelFields, Warnings, error)
+	GetStacks(query ...Query) ([]resources.LabelFields, Warnings, error)
+	GetBuildpacks(query ...Query) ([]resources.LabelFields, Warnings, error)
+	GetServiceBrokers(query ...Query) ([]resources.LabelFields, Warnings, error)
+	GetServiceOfferings(query ...Query) ([]resources.LabelFields, Warnings, error)
+	GetServicePlans(query ...Query) ([]resources.LabelFields, Warnings, error)
+}
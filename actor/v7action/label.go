@@ -0,0 +1,119 @@
+package v7action
+
+import (
+	"fmt"
+
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+)
+
+// ResourceNotFoundError is returned when a named resource has no matching
+// record on the Cloud Controller.
+type ResourceNotFoundError struct {
+	ResourceType string
+	ResourceName string
+}
+
+func (e ResourceNotFoundError) Error() string {
+	return fmt.Sprintf("%s '%s' not found", e.ResourceType, e.ResourceName)
+}
+
+// firstLabels picks the labels off the first (and expected only) match for
+// a name-filtered lookup, translating an empty result into a not-found
+// error the way the rest of the label commands expect.
+func firstLabels(resourceType string, resourceName string, fields []resources.LabelFields, warnings Warnings, err error) (map[string]types.NullString, Warnings, error) {
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	if len(fields) == 0 {
+		return nil, warnings, ResourceNotFoundError{ResourceType: resourceType, ResourceName: resourceName}
+	}
+
+	return fields[0].Labels, warnings, nil
+}
+
+func (actor Actor) GetApplicationLabels(appName string, spaceGUID string) (map[string]types.NullString, Warnings, error) {
+	fields, warnings, err := actor.CloudControllerClient.GetApplications(
+		Query{Key: NameFilter, Values: []string{appName}},
+		Query{Key: SpaceGUIDFilter, Values: []string{spaceGUID}},
+	)
+	return firstLabels("app", appName, fields, warnings, err)
+}
+
+func (actor Actor) GetDomainLabels(domainName string) (map[string]types.NullString, Warnings, error) {
+	fields, warnings, err := actor.CloudControllerClient.GetDomains(
+		Query{Key: NameFilter, Values: []string{domainName}},
+	)
+	return firstLabels("domain", domainName, fields, warnings, err)
+}
+
+func (actor Actor) GetOrganizationLabels(orgName string) (map[string]types.NullString, Warnings, error) {
+	fields, warnings, err := actor.CloudControllerClient.GetOrganizations(
+		Query{Key: NameFilter, Values: []string{orgName}},
+	)
+	return firstLabels("org", orgName, fields, warnings, err)
+}
+
+func (actor Actor) GetRouteLabels(routeName string, spaceGUID string) (map[string]types.NullString, Warnings, error) {
+	fields, warnings, err := actor.CloudControllerClient.GetRoutes(
+		Query{Key: NameFilter, Values: []string{routeName}},
+		Query{Key: SpaceGUIDFilter, Values: []string{spaceGUID}},
+	)
+	return firstLabels("route", routeName, fields, warnings, err)
+}
+
+func (actor Actor) GetSpaceLabels(spaceName string, orgGUID string) (map[string]types.NullString, Warnings, error) {
+	fields, warnings, err := actor.CloudControllerClient.GetSpaces(
+		Query{Key: NameFilter, Values: []string{spaceName}},
+		Query{Key: OrganizationGUIDFilter, Values: []string{orgGUID}},
+	)
+	return firstLabels("space", spaceName, fields, warnings, err)
+}
+
+func (actor Actor) GetBuildpackLabels(buildpackName string, buildpackStack string) (map[string]types.NullString, Warnings, error) {
+	query := []Query{{Key: NameFilter, Values: []string{buildpackName}}}
+	if buildpackStack != "" {
+		query = append(query, Query{Key: StackFilter, Values: []string{buildpackStack}})
+	}
+
+	fields, warnings, err := actor.CloudControllerClient.GetBuildpacks(query...)
+	return firstLabels("buildpack", buildpackName, fields, warnings, err)
+}
+
+func (actor Actor) GetStackLabels(stackName string) (map[string]types.NullString, Warnings, error) {
+	fields, warnings, err := actor.CloudControllerClient.GetStacks(
+		Query{Key: NameFilter, Values: []string{stackName}},
+	)
+	return firstLabels("stack", stackName, fields, warnings, err)
+}
+
+func (actor Actor) GetServiceBrokerLabels(serviceBrokerName string) (map[string]types.NullString, Warnings, error) {
+	fields, warnings, err := actor.CloudControllerClient.GetServiceBrokers(
+		Query{Key: NameFilter, Values: []string{serviceBrokerName}},
+	)
+	return firstLabels("service-broker", serviceBrokerName, fields, warnings, err)
+}
+
+func (actor Actor) GetServiceOfferingLabels(serviceOfferingName, serviceBrokerName string) (map[string]types.NullString, Warnings, error) {
+	query := []Query{{Key: NameFilter, Values: []string{serviceOfferingName}}}
+	if serviceBrokerName != "" {
+		query = append(query, Query{Key: ServiceBrokerNameFilter, Values: []string{serviceBrokerName}})
+	}
+
+	fields, warnings, err := actor.CloudControllerClient.GetServiceOfferings(query...)
+	return firstLabels("service-offering", serviceOfferingName, fields, warnings, err)
+}
+
+func (actor Actor) GetServicePlanLabels(servicePlanName, serviceOfferingName, serviceBrokerName string) (map[string]types.NullString, Warnings, error) {
+	query := []Query{{Key: NameFilter, Values: []string{servicePlanName}}}
+	if serviceOfferingName != "" {
+		query = append(query, Query{Key: ServiceOfferingNameFilter, Values: []string{serviceOfferingName}})
+	}
+	if serviceBrokerName != "" {
+		query = append(query, Query{Key: ServiceBrokerNameFilter, Values: []string{serviceBrokerName}})
+	}
+
+	fields, warnings, err := actor.CloudControllerClient.GetServicePlans(query...)
+	return firstLabels("service-plan", servicePlanName, fields, warnings, err)
+}
@@ -0,0 +1,103 @@
+package v7action
+
+// This file only adds new methods onto the existing Actor (see actor.go)
+// for --recursive's cascaded lookups; it doesn't redeclare Actor or
+// CloudControllerClient.
+
+import "code.cloudfoundry.org/cli/resources"
+
+// ResolveOrganization looks up the named org once so callers that need both
+// its labels and its GUID (e.g. a --recursive cascade that also lists the
+// org's spaces/apps/routes by GUID) don't have to re-resolve the org by
+// name for every subsequent call.
+func (actor Actor) ResolveOrganization(orgName string) (resources.LabelFields, Warnings, error) {
+	orgs, warnings, err := actor.CloudControllerClient.GetOrganizations(
+		Query{Key: NameFilter, Values: []string{orgName}},
+	)
+	if err != nil {
+		return resources.LabelFields{}, warnings, err
+	}
+	if len(orgs) == 0 {
+		return resources.LabelFields{}, warnings, ResourceNotFoundError{ResourceType: "org", ResourceName: orgName}
+	}
+
+	return orgs[0], warnings, nil
+}
+
+// ResolveSpace looks up the named space once, for the same reason
+// ResolveOrganization does.
+func (actor Actor) ResolveSpace(spaceName string, orgGUID string) (resources.LabelFields, Warnings, error) {
+	spaces, warnings, err := actor.CloudControllerClient.GetSpaces(
+		Query{Key: NameFilter, Values: []string{spaceName}},
+		Query{Key: OrganizationGUIDFilter, Values: []string{orgGUID}},
+	)
+	if err != nil {
+		return resources.LabelFields{}, warnings, err
+	}
+	if len(spaces) == 0 {
+		return resources.LabelFields{}, warnings, ResourceNotFoundError{ResourceType: "space", ResourceName: spaceName}
+	}
+
+	return spaces[0], warnings, nil
+}
+
+// ListSpacesInOrgLabels lists the labels of every space belonging to the
+// org identified by orgGUID, for `cf labels org ORG -R`.
+func (actor Actor) ListSpacesInOrgLabels(orgGUID string) ([]resources.LabelFields, Warnings, error) {
+	return actor.CloudControllerClient.GetSpaces(
+		Query{Key: OrganizationGUIDFilter, Values: []string{orgGUID}},
+	)
+}
+
+// ListAppsInOrgLabels lists the labels of every app belonging to the org
+// identified by orgGUID, for `cf labels org ORG -R`.
+func (actor Actor) ListAppsInOrgLabels(orgGUID string) ([]resources.LabelFields, Warnings, error) {
+	return actor.CloudControllerClient.GetApplications(
+		Query{Key: OrganizationGUIDFilter, Values: []string{orgGUID}},
+	)
+}
+
+// ListRoutesInOrgLabels lists the labels of every route belonging to the
+// org identified by orgGUID, for `cf labels org ORG -R`.
+func (actor Actor) ListRoutesInOrgLabels(orgGUID string) ([]resources.LabelFields, Warnings, error) {
+	return actor.CloudControllerClient.GetRoutes(
+		Query{Key: OrganizationGUIDFilter, Values: []string{orgGUID}},
+	)
+}
+
+// ListAppsInSpaceLabels lists the labels of every app belonging to the
+// space identified by spaceGUID, for `cf labels space SPACE -R`.
+func (actor Actor) ListAppsInSpaceLabels(spaceGUID string) ([]resources.LabelFields, Warnings, error) {
+	return actor.CloudControllerClient.GetApplications(
+		Query{Key: SpaceGUIDFilter, Values: []string{spaceGUID}},
+	)
+}
+
+// ListRoutesInSpaceLabels lists the labels of every route belonging to the
+// space identified by spaceGUID, for `cf labels space SPACE -R`.
+func (actor Actor) ListRoutesInSpaceLabels(spaceGUID string) ([]resources.LabelFields, Warnings, error) {
+	return actor.CloudControllerClient.GetRoutes(
+		Query{Key: SpaceGUIDFilter, Values: []string{spaceGUID}},
+	)
+}
+
+// ListServiceOfferingsForBrokerLabels lists the labels of every service
+// offering belonging to the named service broker, for
+// `cf labels service-broker BROKER -R`.
+func (actor Actor) ListServiceOfferingsForBrokerLabels(serviceBrokerName string) ([]resources.LabelFields, Warnings, error) {
+	return actor.CloudControllerClient.GetServiceOfferings(
+		Query{Key: ServiceBrokerNameFilter, Values: []string{serviceBrokerName}},
+	)
+}
+
+// ListServicePlansForOfferingLabels lists the labels of every service plan
+// belonging to the named service offering, for
+// `cf labels service-offering OFFERING -R`.
+func (actor Actor) ListServicePlansForOfferingLabels(serviceOfferingName, serviceBrokerName string) ([]resources.LabelFields, Warnings, error) {
+	query := []Query{{Key: ServiceOfferingNameFilter, Values: []string{serviceOfferingName}}}
+	if serviceBrokerName != "" {
+		query = append(query, Query{Key: ServiceBrokerNameFilter, Values: []string{serviceBrokerName}})
+	}
+
+	return actor.CloudControllerClient.GetServicePlans(query...)
+}
@@ -0,0 +1,223 @@
+package v7action_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/resources"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("label cascade", func() {
+	var (
+		actor                     *v7action.Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v7actionfakes.FakeCloudControllerClient)
+		actor = v7action.NewActor(fakeCloudControllerClient, nil, nil, nil, nil)
+	})
+
+	Describe("ResolveOrganization", func() {
+		When("the org exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationsReturns(
+					[]resources.LabelFields{{Name: "business", GUID: "org-guid"}},
+					v7action.Warnings{"org warning"},
+					nil,
+				)
+			})
+
+			It("returns the org's labels and GUID", func() {
+				org, warnings, err := actor.ResolveOrganization("business")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("org warning"))
+				Expect(org).To(Equal(resources.LabelFields{Name: "business", GUID: "org-guid"}))
+
+				Expect(fakeCloudControllerClient.GetOrganizationsArgsForCall(0)).To(ConsistOf(
+					v7action.Query{Key: v7action.NameFilter, Values: []string{"business"}},
+				))
+			})
+		})
+
+		When("the org doesn't exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationsReturns(nil, v7action.Warnings{"org warning"}, nil)
+			})
+
+			It("returns a ResourceNotFoundError", func() {
+				_, warnings, err := actor.ResolveOrganization("business")
+				Expect(err).To(MatchError(v7action.ResourceNotFoundError{ResourceType: "org", ResourceName: "business"}))
+				Expect(warnings).To(ConsistOf("org warning"))
+			})
+		})
+	})
+
+	Describe("ListAppsInOrgLabels", func() {
+		It("lists apps filtered by the given org GUID, without re-resolving the org", func() {
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]resources.LabelFields{{Name: "dora", GUID: "dora-guid"}},
+				v7action.Warnings{"apps warning"},
+				nil,
+			)
+
+			apps, warnings, err := actor.ListAppsInOrgLabels("org-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("apps warning"))
+			Expect(apps).To(ConsistOf(resources.LabelFields{Name: "dora", GUID: "dora-guid"}))
+
+			Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(ConsistOf(
+				v7action.Query{Key: v7action.OrganizationGUIDFilter, Values: []string{"org-guid"}},
+			))
+			Expect(fakeCloudControllerClient.GetOrganizationsCallCount()).To(Equal(0))
+		})
+	})
+
+	Describe("ListSpacesInOrgLabels", func() {
+		It("lists spaces filtered by the given org GUID", func() {
+			fakeCloudControllerClient.GetSpacesReturns(
+				[]resources.LabelFields{{Name: "dev", GUID: "space-guid"}},
+				v7action.Warnings{"spaces warning"},
+				nil,
+			)
+
+			spaces, warnings, err := actor.ListSpacesInOrgLabels("org-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("spaces warning"))
+			Expect(spaces).To(ConsistOf(resources.LabelFields{Name: "dev", GUID: "space-guid"}))
+
+			Expect(fakeCloudControllerClient.GetSpacesArgsForCall(0)).To(ConsistOf(
+				v7action.Query{Key: v7action.OrganizationGUIDFilter, Values: []string{"org-guid"}},
+			))
+		})
+	})
+
+	Describe("ListRoutesInOrgLabels", func() {
+		It("lists routes filtered by the given org GUID", func() {
+			fakeCloudControllerClient.GetRoutesReturns(
+				[]resources.LabelFields{{Name: "dora.example.com", GUID: "route-guid"}},
+				v7action.Warnings{"routes warning"},
+				nil,
+			)
+
+			routes, warnings, err := actor.ListRoutesInOrgLabels("org-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("routes warning"))
+			Expect(routes).To(ConsistOf(resources.LabelFields{Name: "dora.example.com", GUID: "route-guid"}))
+
+			Expect(fakeCloudControllerClient.GetRoutesArgsForCall(0)).To(ConsistOf(
+				v7action.Query{Key: v7action.OrganizationGUIDFilter, Values: []string{"org-guid"}},
+			))
+		})
+	})
+
+	Describe("ResolveSpace", func() {
+		When("the space exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpacesReturns(
+					[]resources.LabelFields{{Name: "dev", GUID: "space-guid"}},
+					v7action.Warnings{"space warning"},
+					nil,
+				)
+			})
+
+			It("returns the space's labels and GUID", func() {
+				space, warnings, err := actor.ResolveSpace("dev", "org-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("space warning"))
+				Expect(space).To(Equal(resources.LabelFields{Name: "dev", GUID: "space-guid"}))
+
+				Expect(fakeCloudControllerClient.GetSpacesArgsForCall(0)).To(ConsistOf(
+					v7action.Query{Key: v7action.NameFilter, Values: []string{"dev"}},
+					v7action.Query{Key: v7action.OrganizationGUIDFilter, Values: []string{"org-guid"}},
+				))
+			})
+		})
+
+		When("the space doesn't exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpacesReturns(nil, v7action.Warnings{"space warning"}, nil)
+			})
+
+			It("returns a ResourceNotFoundError", func() {
+				_, warnings, err := actor.ResolveSpace("dev", "org-guid")
+				Expect(err).To(MatchError(v7action.ResourceNotFoundError{ResourceType: "space", ResourceName: "dev"}))
+				Expect(warnings).To(ConsistOf("space warning"))
+			})
+		})
+	})
+
+	Describe("ListAppsInSpaceLabels", func() {
+		It("lists apps filtered by the given space GUID", func() {
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]resources.LabelFields{{Name: "dora", GUID: "dora-guid"}},
+				v7action.Warnings{"apps warning"},
+				nil,
+			)
+
+			apps, warnings, err := actor.ListAppsInSpaceLabels("space-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("apps warning"))
+			Expect(apps).To(ConsistOf(resources.LabelFields{Name: "dora", GUID: "dora-guid"}))
+
+			Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(ConsistOf(
+				v7action.Query{Key: v7action.SpaceGUIDFilter, Values: []string{"space-guid"}},
+			))
+		})
+	})
+
+	Describe("ListRoutesInSpaceLabels", func() {
+		It("lists routes filtered by the given space GUID", func() {
+			fakeCloudControllerClient.GetRoutesReturns(
+				[]resources.LabelFields{{Name: "dora.example.com", GUID: "route-guid"}},
+				v7action.Warnings{"routes warning"},
+				nil,
+			)
+
+			routes, warnings, err := actor.ListRoutesInSpaceLabels("space-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("routes warning"))
+			Expect(routes).To(ConsistOf(resources.LabelFields{Name: "dora.example.com", GUID: "route-guid"}))
+
+			Expect(fakeCloudControllerClient.GetRoutesArgsForCall(0)).To(ConsistOf(
+				v7action.Query{Key: v7action.SpaceGUIDFilter, Values: []string{"space-guid"}},
+			))
+		})
+	})
+
+	Describe("ListServicePlansForOfferingLabels", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetServicePlansReturns(
+				[]resources.LabelFields{{Name: "small", GUID: "plan-guid"}},
+				nil,
+				nil,
+			)
+		})
+
+		When("a service broker name is given", func() {
+			It("filters by both offering and broker name", func() {
+				plans, _, err := actor.ListServicePlansForOfferingLabels("my-offering", "my-broker")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(plans).To(ConsistOf(resources.LabelFields{Name: "small", GUID: "plan-guid"}))
+
+				Expect(fakeCloudControllerClient.GetServicePlansArgsForCall(0)).To(ConsistOf(
+					v7action.Query{Key: v7action.ServiceOfferingNameFilter, Values: []string{"my-offering"}},
+					v7action.Query{Key: v7action.ServiceBrokerNameFilter, Values: []string{"my-broker"}},
+				))
+			})
+		})
+
+		When("no service broker name is given", func() {
+			It("filters by offering name only", func() {
+				_, _, err := actor.ListServicePlansForOfferingLabels("my-offering", "")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeCloudControllerClient.GetServicePlansArgsForCall(0)).To(ConsistOf(
+					v7action.Query{Key: v7action.ServiceOfferingNameFilter, Values: []string{"my-offering"}},
+				))
+			})
+		})
+	})
+})
@@ -0,0 +1,158 @@
+package v7action
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"code.cloudfoundry.org/cli/resources"
+)
+
+var (
+	labelExistenceRequirement = regexp.MustCompile(`^!?[A-Za-z0-9][\w./-]*$`)
+	labelSetRequirement       = regexp.MustCompile(`^[A-Za-z0-9][\w./-]*\s+(in|notin)\s+\([^()]+\)$`)
+	labelEqualityRequirement  = regexp.MustCompile(`^[A-Za-z0-9][\w./-]*\s*(==|=|!=)\s*[\w.-]+$`)
+)
+
+// ValidateLabelSelector checks that selector is a syntactically valid
+// Cloud Controller / Kubernetes-style label selector: a comma-separated
+// list of requirements of the form "key", "!key", "key=value",
+// "key==value", "key!=value", "key in (v1,v2)", or "key notin (v1,v2)".
+func ValidateLabelSelector(selector string) error {
+	if strings.TrimSpace(selector) == "" {
+		return fmt.Errorf("label selector may not be empty")
+	}
+
+	for _, requirement := range splitLabelSelector(selector) {
+		requirement = strings.TrimSpace(requirement)
+		if requirement == "" {
+			return fmt.Errorf("invalid label selector %q: empty requirement", selector)
+		}
+
+		switch {
+		case labelSetRequirement.MatchString(requirement):
+		case labelEqualityRequirement.MatchString(requirement):
+		case labelExistenceRequirement.MatchString(requirement):
+		default:
+			return fmt.Errorf("invalid label selector requirement %q", requirement)
+		}
+	}
+
+	return nil
+}
+
+// splitLabelSelector splits selector on top-level commas, leaving commas
+// nested inside an "in (...)"/"notin (...)" value list intact.
+func splitLabelSelector(selector string) []string {
+	var requirements []string
+	depth := 0
+	start := 0
+	for i, r := range selector {
+		switch r {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				requirements = append(requirements, selector[start:i])
+				start = i + 1
+			}
+		}
+	}
+	requirements = append(requirements, selector[start:])
+	return requirements
+}
+
+func (actor Actor) ListApplicationsWithLabelSelector(labelSelector string, spaceGUID string) ([]resources.LabelFields, Warnings, error) {
+	if err := ValidateLabelSelector(labelSelector); err != nil {
+		return nil, nil, err
+	}
+
+	return actor.CloudControllerClient.GetApplications(
+		Query{Key: LabelSelectorFilter, Values: []string{labelSelector}},
+		Query{Key: SpaceGUIDFilter, Values: []string{spaceGUID}},
+	)
+}
+
+func (actor Actor) ListDomainsWithLabelSelector(labelSelector string) ([]resources.LabelFields, Warnings, error) {
+	if err := ValidateLabelSelector(labelSelector); err != nil {
+		return nil, nil, err
+	}
+
+	return actor.CloudControllerClient.GetDomains(
+		Query{Key: LabelSelectorFilter, Values: []string{labelSelector}},
+	)
+}
+
+func (actor Actor) ListOrganizationsWithLabelSelector(labelSelector string) ([]resources.LabelFields, Warnings, error) {
+	if err := ValidateLabelSelector(labelSelector); err != nil {
+		return nil, nil, err
+	}
+
+	return actor.CloudControllerClient.GetOrganizations(
+		Query{Key: LabelSelectorFilter, Values: []string{labelSelector}},
+	)
+}
+
+func (actor Actor) ListRoutesWithLabelSelector(labelSelector string, spaceGUID string) ([]resources.LabelFields, Warnings, error) {
+	if err := ValidateLabelSelector(labelSelector); err != nil {
+		return nil, nil, err
+	}
+
+	return actor.CloudControllerClient.GetRoutes(
+		Query{Key: LabelSelectorFilter, Values: []string{labelSelector}},
+		Query{Key: SpaceGUIDFilter, Values: []string{spaceGUID}},
+	)
+}
+
+func (actor Actor) ListSpacesWithLabelSelector(labelSelector string, orgGUID string) ([]resources.LabelFields, Warnings, error) {
+	if err := ValidateLabelSelector(labelSelector); err != nil {
+		return nil, nil, err
+	}
+
+	return actor.CloudControllerClient.GetSpaces(
+		Query{Key: LabelSelectorFilter, Values: []string{labelSelector}},
+		Query{Key: OrganizationGUIDFilter, Values: []string{orgGUID}},
+	)
+}
+
+func (actor Actor) ListStacksWithLabelSelector(labelSelector string) ([]resources.LabelFields, Warnings, error) {
+	if err := ValidateLabelSelector(labelSelector); err != nil {
+		return nil, nil, err
+	}
+
+	return actor.CloudControllerClient.GetStacks(
+		Query{Key: LabelSelectorFilter, Values: []string{labelSelector}},
+	)
+}
+
+func (actor Actor) ListServiceBrokersWithLabelSelector(labelSelector string) ([]resources.LabelFields, Warnings, error) {
+	if err := ValidateLabelSelector(labelSelector); err != nil {
+		return nil, nil, err
+	}
+
+	return actor.CloudControllerClient.GetServiceBrokers(
+		Query{Key: LabelSelectorFilter, Values: []string{labelSelector}},
+	)
+}
+
+func (actor Actor) ListServiceOfferingsWithLabelSelector(labelSelector string) ([]resources.LabelFields, Warnings, error) {
+	if err := ValidateLabelSelector(labelSelector); err != nil {
+		return nil, nil, err
+	}
+
+	return actor.CloudControllerClient.GetServiceOfferings(
+		Query{Key: LabelSelectorFilter, Values: []string{labelSelector}},
+	)
+}
+
+func (actor Actor) ListServicePlansWithLabelSelector(labelSelector string) ([]resources.LabelFields, Warnings, error) {
+	if err := ValidateLabelSelector(labelSelector); err != nil {
+		return nil, nil, err
+	}
+
+	return actor.CloudControllerClient.GetServicePlans(
+		Query{Key: LabelSelectorFilter, Values: []string{labelSelector}},
+	)
+}
@@ -0,0 +1,84 @@
+package v7action_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/resources"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("label selector", func() {
+	Describe("ValidateLabelSelector", func() {
+		DescribeTable("valid selectors",
+			func(selector string) {
+				Expect(v7action.ValidateLabelSelector(selector)).To(Succeed())
+			},
+			Entry("equality", "env=prod"),
+			Entry("double-equals equality", "env==prod"),
+			Entry("inequality", "tier!=batch"),
+			Entry("existence", "env"),
+			Entry("non-existence", "!env"),
+			Entry("in", "env in (prod,staging)"),
+			Entry("notin", "env notin (prod,staging)"),
+			Entry("comma-separated requirements", "env=prod,tier!=batch,has-owner"),
+			Entry("namespaced key", "team.cloudfoundry.org/owner=ux"),
+		)
+
+		DescribeTable("invalid selectors",
+			func(selector string) {
+				Expect(v7action.ValidateLabelSelector(selector)).NotTo(Succeed())
+			},
+			Entry("empty", ""),
+			Entry("blank", "   "),
+			Entry("trailing comma", "env=prod,"),
+			Entry("bad operator", "env~=prod"),
+			Entry("unclosed in-list", "env in (prod,staging"),
+		)
+	})
+
+	Describe("ListApplicationsWithLabelSelector", func() {
+		var (
+			actor                     *v7action.Actor
+			fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient = new(v7actionfakes.FakeCloudControllerClient)
+			actor = v7action.NewActor(fakeCloudControllerClient, nil, nil, nil, nil)
+		})
+
+		When("the selector is valid", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]resources.LabelFields{{Name: "dora", GUID: "dora-guid"}},
+					v7action.Warnings{"a warning"},
+					nil,
+				)
+			})
+
+			It("passes the raw selector through as the label_selector query param", func() {
+				fields, warnings, err := actor.ListApplicationsWithLabelSelector("env=prod,tier!=batch", "space-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("a warning"))
+				Expect(fields).To(ConsistOf(resources.LabelFields{Name: "dora", GUID: "dora-guid"}))
+
+				Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(1))
+				query := fakeCloudControllerClient.GetApplicationsArgsForCall(0)
+				Expect(query).To(ConsistOf(
+					v7action.Query{Key: v7action.LabelSelectorFilter, Values: []string{"env=prod,tier!=batch"}},
+					v7action.Query{Key: v7action.SpaceGUIDFilter, Values: []string{"space-guid"}},
+				))
+			})
+		})
+
+		When("the selector is invalid", func() {
+			It("returns an error without calling the Cloud Controller", func() {
+				_, _, err := actor.ListApplicationsWithLabelSelector("env~=prod", "space-guid")
+				Expect(err).To(HaveOccurred())
+				Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
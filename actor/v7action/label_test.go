@@ -0,0 +1,112 @@
+package v7action_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Actor", func() {
+	var (
+		actor                     *v7action.Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v7actionfakes.FakeCloudControllerClient)
+		actor = v7action.NewActor(fakeCloudControllerClient, nil, nil, nil, nil)
+	})
+
+	Describe("GetApplicationLabels", func() {
+		When("the app exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]resources.LabelFields{{
+						Name: "dora",
+						GUID: "dora-guid",
+						Labels: map[string]types.NullString{
+							"env": {Value: "prod", IsSet: true},
+						},
+					}},
+					v7action.Warnings{"a warning"},
+					nil,
+				)
+			})
+
+			It("returns its labels, filtered by name and space", func() {
+				labels, warnings, err := actor.GetApplicationLabels("dora", "space-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("a warning"))
+				Expect(labels).To(Equal(map[string]types.NullString{
+					"env": {Value: "prod", IsSet: true},
+				}))
+
+				Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(ConsistOf(
+					v7action.Query{Key: v7action.NameFilter, Values: []string{"dora"}},
+					v7action.Query{Key: v7action.SpaceGUIDFilter, Values: []string{"space-guid"}},
+				))
+			})
+		})
+
+		When("no app matches", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, v7action.Warnings{"a warning"}, nil)
+			})
+
+			It("returns a ResourceNotFoundError", func() {
+				_, warnings, err := actor.GetApplicationLabels("dora", "space-guid")
+				Expect(err).To(MatchError(v7action.ResourceNotFoundError{ResourceType: "app", ResourceName: "dora"}))
+				Expect(warnings).To(ConsistOf("a warning"))
+			})
+		})
+
+		When("the Cloud Controller call errors", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, v7action.Warnings{"a warning"}, ccError)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := actor.GetApplicationLabels("dora", "space-guid")
+				Expect(err).To(MatchError(ccError))
+				Expect(warnings).To(ConsistOf("a warning"))
+			})
+		})
+	})
+
+	Describe("GetOrganizationLabels", func() {
+		When("the org exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationsReturns(
+					[]resources.LabelFields{{Name: "business", GUID: "org-guid"}},
+					nil,
+					nil,
+				)
+			})
+
+			It("returns its labels", func() {
+				labels, _, err := actor.GetOrganizationLabels("business")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(labels).To(BeEmpty())
+
+				Expect(fakeCloudControllerClient.GetOrganizationsArgsForCall(0)).To(ConsistOf(
+					v7action.Query{Key: v7action.NameFilter, Values: []string{"business"}},
+				))
+			})
+		})
+	})
+})
+
+var ccError = ccClientError{"boom"}
+
+type ccClientError struct {
+	message string
+}
+
+func (e ccClientError) Error() string {
+	return e.message
+}
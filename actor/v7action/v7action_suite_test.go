@@ -0,0 +1,13 @@
+package v7action_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestActor(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Actor Suite")
+}
@@ -0,0 +1,10 @@
+package flag
+
+// LabelsArgs are the positional arguments to `cf labels`. RESOURCE_NAME is
+// only required when listing labels for a single named resource; it's
+// ignored (and may be omitted) when --selector/-l is used to list labels
+// across every resource matching the selector.
+type LabelsArgs struct {
+	ResourceType string `positional-arg-name:"RESOURCE" required:"true"`
+	ResourceName string `positional-arg-name:"RESOURCE_NAME" required:"false"`
+}
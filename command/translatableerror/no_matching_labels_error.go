@@ -0,0 +1,15 @@
+package translatableerror
+
+// NoMatchingLabelsError is returned when a label predicate flag (--has-key,
+// --key-absent, --value-in, --value-not-in) is set but no labels on the
+// resource satisfy it.
+type NoMatchingLabelsError struct {
+}
+
+func (e NoMatchingLabelsError) Error() string {
+	return "No matching labels found."
+}
+
+func (e NoMatchingLabelsError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
@@ -0,0 +1,17 @@
+package translatableerror
+
+// RequiredArgumentError is returned when a positional argument that the
+// current flag combination requires was left off the command line.
+type RequiredArgumentError struct {
+	ArgumentName string
+}
+
+func (e RequiredArgumentError) Error() string {
+	return "Incorrect Usage: the required argument `{{.ArgumentName}}` was not provided"
+}
+
+func (e RequiredArgumentError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"ArgumentName": e.ArgumentName,
+	})
+}
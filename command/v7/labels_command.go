@@ -2,7 +2,6 @@ package v7
 
 import (
 	"fmt"
-	"sort"
 	"strings"
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
@@ -11,8 +10,8 @@ import (
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/resources"
 	"code.cloudfoundry.org/cli/types"
-	"code.cloudfoundry.org/cli/util/ui"
 	"code.cloudfoundry.org/clock"
 )
 
@@ -44,15 +43,45 @@ type LabelsActor interface {
 	GetServiceBrokerLabels(serviceBrokerName string) (map[string]types.NullString, v7action.Warnings, error)
 	GetServiceOfferingLabels(serviceOfferingName, serviceBrokerName string) (map[string]types.NullString, v7action.Warnings, error)
 	GetServicePlanLabels(servicePlanName, serviceOfferingName, serviceBrokerName string) (map[string]types.NullString, v7action.Warnings, error)
+
+	ListApplicationsWithLabelSelector(labelSelector string, spaceGUID string) ([]resources.LabelFields, v7action.Warnings, error)
+	ListDomainsWithLabelSelector(labelSelector string) ([]resources.LabelFields, v7action.Warnings, error)
+	ListOrganizationsWithLabelSelector(labelSelector string) ([]resources.LabelFields, v7action.Warnings, error)
+	ListRoutesWithLabelSelector(labelSelector string, spaceGUID string) ([]resources.LabelFields, v7action.Warnings, error)
+	ListSpacesWithLabelSelector(labelSelector string, orgGUID string) ([]resources.LabelFields, v7action.Warnings, error)
+	ListStacksWithLabelSelector(labelSelector string) ([]resources.LabelFields, v7action.Warnings, error)
+	ListServiceBrokersWithLabelSelector(labelSelector string) ([]resources.LabelFields, v7action.Warnings, error)
+	ListServiceOfferingsWithLabelSelector(labelSelector string) ([]resources.LabelFields, v7action.Warnings, error)
+	ListServicePlansWithLabelSelector(labelSelector string) ([]resources.LabelFields, v7action.Warnings, error)
+
+	ListSpacesInOrgLabels(orgGUID string) ([]resources.LabelFields, v7action.Warnings, error)
+	ListAppsInOrgLabels(orgGUID string) ([]resources.LabelFields, v7action.Warnings, error)
+	ListRoutesInOrgLabels(orgGUID string) ([]resources.LabelFields, v7action.Warnings, error)
+	ListAppsInSpaceLabels(spaceGUID string) ([]resources.LabelFields, v7action.Warnings, error)
+	ListRoutesInSpaceLabels(spaceGUID string) ([]resources.LabelFields, v7action.Warnings, error)
+	ListServiceOfferingsForBrokerLabels(serviceBrokerName string) ([]resources.LabelFields, v7action.Warnings, error)
+	ListServicePlansForOfferingLabels(serviceOfferingName, serviceBrokerName string) ([]resources.LabelFields, v7action.Warnings, error)
+
+	ResolveOrganization(orgName string) (resources.LabelFields, v7action.Warnings, error)
+	ResolveSpace(spaceName string, orgGUID string) (resources.LabelFields, v7action.Warnings, error)
 }
 
 type LabelsCommand struct {
 	RequiredArgs    flag.LabelsArgs `positional-args:"yes"`
 	BuildpackStack  string          `long:"stack" short:"s" description:"Specify stack to disambiguate buildpacks with the same name"`
-	usage           interface{}     `usage:"CF_NAME labels RESOURCE RESOURCE_NAME\n\nEXAMPLES:\n   cf labels app dora\n   cf labels org business\n   cf labels buildpack go_buildpack --stack cflinuxfs3 \n\nRESOURCES:\n   app\n   buildpack\n   domain\n   org\n   route\n   service-broker\n   service-offering\n   service-plan\n   space\n   stack"`
+	usage           interface{}     `usage:"CF_NAME labels RESOURCE RESOURCE_NAME\n   CF_NAME labels RESOURCE -l SELECTOR\n\nEXAMPLES:\n   cf labels app dora\n   cf labels org business\n   cf labels org business -R\n   cf labels app -l env=prod,tier!=batch\n   cf labels app dora --has-key team.cloudfoundry.org/owner\n   cf labels buildpack go_buildpack --stack cflinuxfs3 \n\nRESOURCES:\n   app\n   buildpack\n   domain\n   org\n   route\n   service-broker\n   service-offering\n   service-plan\n   space\n   stack"`
 	relatedCommands interface{}     `related_commands:"set-label, unset-label"`
 	ServiceBroker   string          `long:"broker" short:"b" description:"Specify a service broker to disambiguate service offerings or service plans with the same name."`
 	ServiceOffering string          `long:"offering" short:"e" description:"Specify a service offering to disambiguate service plans with the same name."`
+	Selector        string          `long:"selector" short:"l" description:"Filter resources by label selector, e.g. 'env=prod,tier!=batch'. RESOURCE_NAME is ignored when this flag is set."`
+	Output          string          `long:"output" short:"o" description:"Output format: json or yaml. Defaults to table output."`
+	ShowNull        bool            `long:"show-null" description:"Render unset label values as null in json/yaml output instead of omitting them."`
+	Recursive       bool            `long:"recursive" short:"R" description:"Also print labels for descendant resources (org: spaces, apps, routes; space: apps, routes; service-broker: offerings; service-offering: plans)"`
+	Depth           int             `long:"depth" description:"Limit --recursive traversal to N levels" default:"-1"`
+	HasKey          string          `long:"has-key" description:"Only show labels if this key is set"`
+	KeyAbsent       string          `long:"key-absent" description:"Require that this key is not set"`
+	ValueIn         string          `long:"value-in" description:"key=v1,v2 - only show labels if the key's value is one of the given values"`
+	ValueNotIn      string          `long:"value-not-in" description:"key=v1,v2 - only show labels if the key's value is not one of the given values"`
 
 	UI          command.UI
 	Config      command.Config
@@ -94,6 +123,14 @@ func (cmd LabelsCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.Selector != "" {
+		return cmd.executeWithSelector()
+	}
+
+	if cmd.Recursive {
+		return cmd.executeRecursive()
+	}
+
 	switch cmd.canonicalResourceTypeForName() {
 	case App:
 		cmd.displayMessageWithOrgAndSpace()
@@ -133,42 +170,441 @@ func (cmd LabelsCommand) Execute(args []string) error {
 		return err
 	}
 
-	cmd.printLabels(labels)
-	return nil
+	labels, err = cmd.filterLabels(labels)
+	if err != nil {
+		return err
+	}
+
+	return cmd.printLabels(labels)
+}
+
+func (cmd LabelsCommand) executeWithSelector() error {
+	var (
+		resourceLabels []resources.LabelFields
+		warnings       v7action.Warnings
+		err            error
+	)
+
+	cmd.UI.DisplayTextWithFlavor("Getting labels for {{.ResourceType}}s matching selector {{.Selector}} as {{.User}}...", map[string]interface{}{
+		"ResourceType": cmd.RequiredArgs.ResourceType,
+		"Selector":     cmd.Selector,
+		"User":         cmd.username,
+	})
+	cmd.UI.DisplayNewline()
+
+	switch cmd.canonicalResourceTypeForName() {
+	case App:
+		resourceLabels, warnings, err = cmd.Actor.ListApplicationsWithLabelSelector(cmd.Selector, cmd.Config.TargetedSpace().GUID)
+	case Domain:
+		resourceLabels, warnings, err = cmd.Actor.ListDomainsWithLabelSelector(cmd.Selector)
+	case Org:
+		resourceLabels, warnings, err = cmd.Actor.ListOrganizationsWithLabelSelector(cmd.Selector)
+	case Route:
+		resourceLabels, warnings, err = cmd.Actor.ListRoutesWithLabelSelector(cmd.Selector, cmd.Config.TargetedSpace().GUID)
+	case ServiceBroker:
+		resourceLabels, warnings, err = cmd.Actor.ListServiceBrokersWithLabelSelector(cmd.Selector)
+	case ServiceOffering:
+		resourceLabels, warnings, err = cmd.Actor.ListServiceOfferingsWithLabelSelector(cmd.Selector)
+	case ServicePlan:
+		resourceLabels, warnings, err = cmd.Actor.ListServicePlansWithLabelSelector(cmd.Selector)
+	case Space:
+		resourceLabels, warnings, err = cmd.Actor.ListSpacesWithLabelSelector(cmd.Selector, cmd.Config.TargetedOrganization().GUID)
+	case Stack:
+		resourceLabels, warnings, err = cmd.Actor.ListStacksWithLabelSelector(cmd.Selector)
+	default:
+		err = fmt.Errorf("Unsupported resource type of '%s'", cmd.RequiredArgs.ResourceType)
+	}
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	return cmd.printLabelsList(resourceLabels)
+}
+
+func (cmd LabelsCommand) executeRecursive() error {
+	var (
+		groups   []shared.LabelGroup
+		warnings v7action.Warnings
+		err      error
+	)
+
+	switch cmd.canonicalResourceTypeForName() {
+	case Org:
+		groups, warnings, err = cmd.buildOrgGroups(cmd.RequiredArgs.ResourceName, cmd.Depth)
+	case Space:
+		groups, warnings, err = cmd.buildSpaceGroups(cmd.RequiredArgs.ResourceName, cmd.Depth)
+	case ServiceBroker:
+		groups, warnings, err = cmd.buildServiceBrokerGroups(cmd.RequiredArgs.ResourceName, cmd.Depth)
+	case ServiceOffering:
+		groups, warnings, err = cmd.buildServiceOfferingGroups(cmd.RequiredArgs.ResourceName, cmd.ServiceBroker, cmd.Depth)
+	default:
+		err = translatableerror.ArgumentCombinationError{
+			Args: []string{
+				cmd.RequiredArgs.ResourceType, "--recursive, -R",
+			},
+		}
+	}
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	return cmd.printGroupedLabels(groups)
+}
+
+func (cmd LabelsCommand) buildOrgGroups(orgName string, depth int) ([]shared.LabelGroup, v7action.Warnings, error) {
+	var allWarnings v7action.Warnings
+
+	org, warnings, err := cmd.Actor.ResolveOrganization(orgName)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	groups := []shared.LabelGroup{{Title: "org " + orgName, Resources: []resources.LabelFields{{Name: orgName, Labels: org.Labels}}}}
+	if depth == 0 {
+		return groups, allWarnings, nil
+	}
+
+	spaces, warnings, err := cmd.Actor.ListSpacesInOrgLabels(org.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return groups, allWarnings, err
+	}
+	groups = append(groups, shared.LabelGroup{Title: "spaces", Resources: spaces})
+	if depth == 1 {
+		return groups, allWarnings, nil
+	}
+
+	apps, warnings, err := cmd.Actor.ListAppsInOrgLabels(org.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return groups, allWarnings, err
+	}
+	groups = append(groups, shared.LabelGroup{Title: "apps", Resources: apps})
+
+	routes, warnings, err := cmd.Actor.ListRoutesInOrgLabels(org.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return groups, allWarnings, err
+	}
+	groups = append(groups, shared.LabelGroup{Title: "routes", Resources: routes})
+
+	return groups, allWarnings, nil
+}
+
+func (cmd LabelsCommand) buildSpaceGroups(spaceName string, depth int) ([]shared.LabelGroup, v7action.Warnings, error) {
+	var allWarnings v7action.Warnings
+	orgGUID := cmd.Config.TargetedOrganization().GUID
+
+	space, warnings, err := cmd.Actor.ResolveSpace(spaceName, orgGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	groups := []shared.LabelGroup{{Title: "space " + spaceName, Resources: []resources.LabelFields{{Name: spaceName, Labels: space.Labels}}}}
+	if depth == 0 {
+		return groups, allWarnings, nil
+	}
+
+	apps, warnings, err := cmd.Actor.ListAppsInSpaceLabels(space.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return groups, allWarnings, err
+	}
+	groups = append(groups, shared.LabelGroup{Title: "apps", Resources: apps})
+
+	routes, warnings, err := cmd.Actor.ListRoutesInSpaceLabels(space.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return groups, allWarnings, err
+	}
+	groups = append(groups, shared.LabelGroup{Title: "routes", Resources: routes})
+
+	return groups, allWarnings, nil
+}
+
+func (cmd LabelsCommand) buildServiceBrokerGroups(serviceBrokerName string, depth int) ([]shared.LabelGroup, v7action.Warnings, error) {
+	var allWarnings v7action.Warnings
+
+	brokerLabels, warnings, err := cmd.Actor.GetServiceBrokerLabels(serviceBrokerName)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	groups := []shared.LabelGroup{{Title: "service-broker " + serviceBrokerName, Resources: []resources.LabelFields{{Name: serviceBrokerName, Labels: brokerLabels}}}}
+	if depth == 0 {
+		return groups, allWarnings, nil
+	}
+
+	offerings, warnings, err := cmd.Actor.ListServiceOfferingsForBrokerLabels(serviceBrokerName)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return groups, allWarnings, err
+	}
+	groups = append(groups, shared.LabelGroup{Title: "service offerings", Resources: offerings})
+
+	return groups, allWarnings, nil
+}
+
+func (cmd LabelsCommand) buildServiceOfferingGroups(serviceOfferingName string, serviceBrokerName string, depth int) ([]shared.LabelGroup, v7action.Warnings, error) {
+	var allWarnings v7action.Warnings
+
+	offeringLabels, warnings, err := cmd.Actor.GetServiceOfferingLabels(serviceOfferingName, serviceBrokerName)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	groups := []shared.LabelGroup{{Title: "service-offering " + serviceOfferingName, Resources: []resources.LabelFields{{Name: serviceOfferingName, Labels: offeringLabels}}}}
+	if depth == 0 {
+		return groups, allWarnings, nil
+	}
+
+	plans, warnings, err := cmd.Actor.ListServicePlansForOfferingLabels(serviceOfferingName, serviceBrokerName)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return groups, allWarnings, err
+	}
+	groups = append(groups, shared.LabelGroup{Title: "service plans", Resources: plans})
+
+	return groups, allWarnings, nil
+}
+
+func (cmd LabelsCommand) printGroupedLabels(groups []shared.LabelGroup) error {
+	printer, err := cmd.labelPrinter()
+	if err != nil {
+		return err
+	}
+
+	return printer.PrintGrouped(groups)
 }
 
 func (cmd LabelsCommand) canonicalResourceTypeForName() ResourceType {
 	return ResourceType(strings.ToLower(cmd.RequiredArgs.ResourceType))
 }
 
-func (cmd LabelsCommand) printLabels(labels map[string]types.NullString) {
-	if len(labels) == 0 {
-		cmd.UI.DisplayText("No labels found.")
-		return
+// labelPredicateOperator mirrors the Kubernetes label selector requirement
+// operators that apply to a single already-fetched label map.
+type labelPredicateOperator string
+
+const (
+	labelKeyExists    labelPredicateOperator = "Exists"
+	labelKeyNotExists labelPredicateOperator = "NotExists"
+	labelValueIn      labelPredicateOperator = "In"
+	labelValueNotIn   labelPredicateOperator = "NotIn"
+)
+
+type labelPredicate struct {
+	Key      string
+	Operator labelPredicateOperator
+	Values   []string
+}
+
+func (cmd LabelsCommand) labelPredicates() ([]labelPredicate, error) {
+	var predicates []labelPredicate
+
+	if cmd.HasKey != "" {
+		predicates = append(predicates, labelPredicate{Key: cmd.HasKey, Operator: labelKeyExists})
+	}
+
+	if cmd.KeyAbsent != "" {
+		predicates = append(predicates, labelPredicate{Key: cmd.KeyAbsent, Operator: labelKeyNotExists})
+	}
+
+	if cmd.ValueIn != "" {
+		predicate, err := parseKeyValuesPredicate(cmd.ValueIn, labelValueIn, "--value-in")
+		if err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, predicate)
+	}
+
+	if cmd.ValueNotIn != "" {
+		predicate, err := parseKeyValuesPredicate(cmd.ValueNotIn, labelValueNotIn, "--value-not-in")
+		if err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, predicate)
+	}
+
+	return predicates, nil
+}
+
+func parseKeyValuesPredicate(raw string, operator labelPredicateOperator, flagName string) (labelPredicate, error) {
+	parts := strings.SplitN(raw, "=", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return labelPredicate{}, fmt.Errorf("Invalid argument for '%s': expected format 'key=value1,value2'", flagName)
 	}
 
-	keys := make([]string, 0, len(labels))
-	for key := range labels {
-		keys = append(keys, key)
+	return labelPredicate{Key: parts[0], Operator: operator, Values: strings.Split(parts[1], ",")}, nil
+}
+
+// filterLabels checks labels against every configured predicate flag
+// (--has-key, --key-absent, --value-in, --value-not-in) and returns
+// translatableerror.NoMatchingLabelsError if any predicate fails to match.
+// The predicates are a gate, not a projection: a key-absent or value-not-in
+// predicate passing has no positive key/value of its own to report, so on
+// success filterLabels returns labels unchanged rather than building up a
+// subset that would otherwise come back empty whenever a negative predicate
+// was the only one that matched.
+func (cmd LabelsCommand) filterLabels(labels map[string]types.NullString) (map[string]types.NullString, error) {
+	predicates, err := cmd.labelPredicates()
+	if err != nil {
+		return nil, err
 	}
-	sort.Strings(keys)
 
-	table := [][]string{
-		{
-			cmd.UI.TranslateText("key"),
-			cmd.UI.TranslateText("value"),
-		},
+	for _, predicate := range predicates {
+		value, isSet := labels[predicate.Key]
+
+		switch predicate.Operator {
+		case labelKeyExists:
+			if !isSet {
+				return nil, translatableerror.NoMatchingLabelsError{}
+			}
+		case labelKeyNotExists:
+			if isSet {
+				return nil, translatableerror.NoMatchingLabelsError{}
+			}
+		case labelValueIn:
+			if !isSet || !containsString(predicate.Values, value.Value) {
+				return nil, translatableerror.NoMatchingLabelsError{}
+			}
+		case labelValueNotIn:
+			if isSet && containsString(predicate.Values, value.Value) {
+				return nil, translatableerror.NoMatchingLabelsError{}
+			}
+		}
 	}
 
-	for _, key := range keys {
-		table = append(table, []string{key, labels[key].Value})
+	return labels, nil
+}
+
+func containsString(haystack []string, needle string) bool {
+	for _, candidate := range haystack {
+		if candidate == needle {
+			return true
+		}
+	}
+	return false
+}
+
+func (cmd LabelsCommand) printLabels(labels map[string]types.NullString) error {
+	printer, err := cmd.labelPrinter()
+	if err != nil {
+		return err
+	}
+
+	return printer.Print(labels)
+}
+
+func (cmd LabelsCommand) labelPrinter() (shared.LabelPrinter, error) {
+	switch strings.ToLower(cmd.Output) {
+	case "", "table":
+		return shared.TableLabelPrinter{UI: cmd.UI}, nil
+	case "json":
+		return shared.JSONLabelPrinter{UI: cmd.UI, ShowNull: cmd.ShowNull}, nil
+	case "yaml":
+		return shared.YAMLLabelPrinter{UI: cmd.UI, ShowNull: cmd.ShowNull}, nil
+	default:
+		return nil, fmt.Errorf("Unsupported output format of '%s'", cmd.Output)
+	}
+}
+
+func (cmd LabelsCommand) printLabelsList(resourceLabels []resources.LabelFields) error {
+	printer, err := cmd.labelPrinter()
+	if err != nil {
+		return err
 	}
 
-	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+	return printer.PrintList(resourceLabels)
 }
 
 func (cmd LabelsCommand) validateFlags() error {
 	resourceType := cmd.canonicalResourceTypeForName()
+
+	if cmd.Selector == "" && cmd.RequiredArgs.ResourceName == "" {
+		return translatableerror.RequiredArgumentError{
+			ArgumentName: "RESOURCE_NAME",
+		}
+	}
+
+	if cmd.HasKey != "" && cmd.HasKey == cmd.KeyAbsent {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{
+				"--has-key", "--key-absent",
+			},
+		}
+	}
+
+	predicates, err := cmd.labelPredicates()
+	if err != nil {
+		return err
+	}
+
+	if len(predicates) > 0 && (cmd.Selector != "" || cmd.Recursive) {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{
+				"--has-key, --key-absent, --value-in, --value-not-in", "--selector, -l / --recursive, -R",
+			},
+		}
+	}
+
+	seenKeys := make(map[string]bool)
+	for _, predicate := range predicates {
+		if seenKeys[predicate.Key] {
+			return translatableerror.ArgumentCombinationError{
+				Args: []string{
+					"--has-key", "--key-absent", "--value-in", "--value-not-in",
+				},
+			}
+		}
+		seenKeys[predicate.Key] = true
+	}
+
+	if cmd.ShowNull && cmd.Output == "" {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{
+				"--show-null", "--output, -o",
+			},
+		}
+	}
+
+	if cmd.Selector != "" && resourceType == Buildpack {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{
+				cmd.RequiredArgs.ResourceType, "--selector, -l",
+			},
+		}
+	}
+
+	if cmd.Selector != "" && cmd.Recursive {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{
+				"--selector, -l", "--recursive, -R",
+			},
+		}
+	}
+
+	if cmd.Depth != -1 && !cmd.Recursive {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{
+				"--depth", "--recursive, -R",
+			},
+		}
+	}
+
+	if cmd.Recursive && !(resourceType == Org || resourceType == Space || resourceType == ServiceBroker || resourceType == ServiceOffering) {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{
+				cmd.RequiredArgs.ResourceType, "--recursive, -R",
+			},
+		}
+	}
+
 	if cmd.BuildpackStack != "" && resourceType != Buildpack {
 		return translatableerror.ArgumentCombinationError{
 			Args: []string{
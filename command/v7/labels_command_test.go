@@ -0,0 +1,159 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/types"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LabelsCommand", func() {
+	Describe("filterLabels", func() {
+		var labels map[string]types.NullString
+
+		BeforeEach(func() {
+			labels = map[string]types.NullString{
+				"env":  {Value: "prod", IsSet: true},
+				"tier": {Value: "web", IsSet: true},
+			}
+		})
+
+		When("no predicate flags are set", func() {
+			It("returns the labels unchanged", func() {
+				cmd := LabelsCommand{}
+				filtered, err := cmd.filterLabels(labels)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(filtered).To(Equal(labels))
+			})
+		})
+
+		When("--key-absent matches a key the resource doesn't have", func() {
+			It("returns every label, not just the absent key (regression for issue where this returned empty)", func() {
+				cmd := LabelsCommand{KeyAbsent: "deprecated"}
+				filtered, err := cmd.filterLabels(labels)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(filtered).To(Equal(labels))
+			})
+		})
+
+		When("--key-absent matches a key the resource does have", func() {
+			It("returns NoMatchingLabelsError", func() {
+				cmd := LabelsCommand{KeyAbsent: "env"}
+				_, err := cmd.filterLabels(labels)
+				Expect(err).To(MatchError(translatableerror.NoMatchingLabelsError{}))
+			})
+		})
+
+		When("--value-not-in matches because the key is unset", func() {
+			It("returns every label, not just the matched key", func() {
+				cmd := LabelsCommand{ValueNotIn: "deprecated=true,yes"}
+				filtered, err := cmd.filterLabels(labels)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(filtered).To(Equal(labels))
+			})
+		})
+
+		When("--value-not-in matches because the value isn't in the list", func() {
+			It("returns every label", func() {
+				cmd := LabelsCommand{ValueNotIn: "env=staging,dev"}
+				filtered, err := cmd.filterLabels(labels)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(filtered).To(Equal(labels))
+			})
+		})
+
+		When("--value-not-in matches a value that IS in the list", func() {
+			It("returns NoMatchingLabelsError", func() {
+				cmd := LabelsCommand{ValueNotIn: "env=prod,staging"}
+				_, err := cmd.filterLabels(labels)
+				Expect(err).To(MatchError(translatableerror.NoMatchingLabelsError{}))
+			})
+		})
+
+		When("--has-key matches a key the resource has", func() {
+			It("returns every label", func() {
+				cmd := LabelsCommand{HasKey: "env"}
+				filtered, err := cmd.filterLabels(labels)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(filtered).To(Equal(labels))
+			})
+		})
+
+		When("--has-key doesn't match any key the resource has", func() {
+			It("returns NoMatchingLabelsError", func() {
+				cmd := LabelsCommand{HasKey: "missing"}
+				_, err := cmd.filterLabels(labels)
+				Expect(err).To(MatchError(translatableerror.NoMatchingLabelsError{}))
+			})
+		})
+
+		When("--value-in is malformed", func() {
+			It("returns an error instead of silently matching everything", func() {
+				cmd := LabelsCommand{ValueIn: "novalue"}
+				_, err := cmd.filterLabels(labels)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("validateFlags", func() {
+		When("RESOURCE_NAME is omitted but --selector is set", func() {
+			It("does not require RESOURCE_NAME", func() {
+				cmd := LabelsCommand{
+					RequiredArgs: flag.LabelsArgs{ResourceType: "app"},
+					Selector:     "env=prod",
+					Depth:        -1,
+				}
+				Expect(cmd.validateFlags()).NotTo(HaveOccurred())
+			})
+		})
+
+		When("RESOURCE_NAME and --selector are both omitted", func() {
+			It("returns RequiredArgumentError", func() {
+				cmd := LabelsCommand{
+					RequiredArgs: flag.LabelsArgs{ResourceType: "app"},
+				}
+				Expect(cmd.validateFlags()).To(MatchError(translatableerror.RequiredArgumentError{
+					ArgumentName: "RESOURCE_NAME",
+				}))
+			})
+		})
+
+		When("--selector is combined with --recursive", func() {
+			It("returns ArgumentCombinationError", func() {
+				cmd := LabelsCommand{
+					RequiredArgs: flag.LabelsArgs{ResourceType: "org", ResourceName: "business"},
+					Selector:     "env=prod",
+					Recursive:    true,
+					Depth:        -1,
+				}
+				Expect(cmd.validateFlags()).To(HaveOccurred())
+			})
+		})
+
+		When("--has-key is combined with --selector", func() {
+			It("returns ArgumentCombinationError", func() {
+				cmd := LabelsCommand{
+					RequiredArgs: flag.LabelsArgs{ResourceType: "app"},
+					Selector:     "env=prod",
+					HasKey:       "env",
+					Depth:        -1,
+				}
+				Expect(cmd.validateFlags()).To(HaveOccurred())
+			})
+		})
+
+		When("only --has-key is set on a plain lookup", func() {
+			It("succeeds", func() {
+				cmd := LabelsCommand{
+					RequiredArgs: flag.LabelsArgs{ResourceType: "app", ResourceName: "dora"},
+					HasKey:       "env",
+					Depth:        -1,
+				}
+				Expect(cmd.validateFlags()).NotTo(HaveOccurred())
+			})
+		})
+	})
+})
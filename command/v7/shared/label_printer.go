@@ -0,0 +1,263 @@
+package shared
+
+import (
+	"encoding/json"
+	"sort"
+
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/ui"
+	"gopkg.in/yaml.v2"
+)
+
+// LabelGroup is a named collection of labelled resources, printed together
+// under its own section by PrintGrouped (e.g. `cf labels org business -R`).
+type LabelGroup struct {
+	Title     string
+	Resources []resources.LabelFields
+}
+
+// LabelPrinter renders labels to the user in a particular output format.
+// Print handles the single-resource case (plain `cf labels RESOURCE NAME`),
+// PrintList the multi-resource case (`-l/--selector`), and PrintGrouped the
+// cascading case (`-R/--recursive`). Every implementation must honor the
+// same output format for all three, so `-o`/`--show-null` keep working
+// regardless of which mode produced the labels.
+type LabelPrinter interface {
+	Print(labels map[string]types.NullString) error
+	PrintList(resourceLabels []resources.LabelFields) error
+	PrintGrouped(groups []LabelGroup) error
+}
+
+// TableLabelPrinter renders labels as a sorted key/value table, matching the
+// default `cf labels` output.
+type TableLabelPrinter struct {
+	UI command.UI
+}
+
+func (p TableLabelPrinter) Print(labels map[string]types.NullString) error {
+	if len(labels) == 0 {
+		p.UI.DisplayText("No labels found.")
+		return nil
+	}
+
+	keys := make([]string, 0, len(labels))
+	for key := range labels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	table := [][]string{
+		{
+			p.UI.TranslateText("key"),
+			p.UI.TranslateText("value"),
+		},
+	}
+
+	for _, key := range keys {
+		table = append(table, []string{key, labels[key].Value})
+	}
+
+	p.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+	return nil
+}
+
+func (p TableLabelPrinter) PrintList(resourceLabels []resources.LabelFields) error {
+	if len(resourceLabels) == 0 {
+		p.UI.DisplayText("No matching resources found.")
+		return nil
+	}
+
+	keys := labelKeys(resourceLabels)
+	header := []string{p.UI.TranslateText("name")}
+	header = append(header, keys...)
+	table := [][]string{header}
+
+	for _, row := range sortedByName(resourceLabels) {
+		table = append(table, labelRow(row, keys))
+	}
+
+	p.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+	return nil
+}
+
+func (p TableLabelPrinter) PrintGrouped(groups []LabelGroup) error {
+	for i, group := range groups {
+		p.UI.DisplayText(group.Title + ":")
+		if err := p.PrintList(group.Resources); err != nil {
+			return err
+		}
+		if i < len(groups)-1 {
+			p.UI.DisplayNewline()
+		}
+	}
+	return nil
+}
+
+// labelKeys returns the sorted union of label keys across resourceLabels,
+// used as the table column set for multi-resource table output.
+func labelKeys(resourceLabels []resources.LabelFields) []string {
+	keySet := make(map[string]bool)
+	for _, resource := range resourceLabels {
+		for key := range resource.Labels {
+			keySet[key] = true
+		}
+	}
+
+	keys := make([]string, 0, len(keySet))
+	for key := range keySet {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func sortedByName(resourceLabels []resources.LabelFields) []resources.LabelFields {
+	sorted := make([]resources.LabelFields, len(resourceLabels))
+	copy(sorted, resourceLabels)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Name < sorted[j].Name
+	})
+	return sorted
+}
+
+func labelRow(resource resources.LabelFields, keys []string) []string {
+	row := []string{resource.Name}
+	for _, key := range keys {
+		row = append(row, resource.Labels[key].Value)
+	}
+	return row
+}
+
+// JSONLabelPrinter renders labels as a JSON object, for piping into tools
+// like jq.
+type JSONLabelPrinter struct {
+	UI       command.UI
+	ShowNull bool
+}
+
+func (p JSONLabelPrinter) Print(labels map[string]types.NullString) error {
+	raw, err := json.MarshalIndent(labelValues(labels, p.ShowNull), "", "  ")
+	if err != nil {
+		return err
+	}
+
+	p.UI.DisplayText(string(raw))
+	return nil
+}
+
+func (p JSONLabelPrinter) PrintList(resourceLabels []resources.LabelFields) error {
+	raw, err := json.MarshalIndent(namedLabelValues(resourceLabels, p.ShowNull), "", "  ")
+	if err != nil {
+		return err
+	}
+
+	p.UI.DisplayText(string(raw))
+	return nil
+}
+
+func (p JSONLabelPrinter) PrintGrouped(groups []LabelGroup) error {
+	raw, err := json.MarshalIndent(groupedLabelValues(groups, p.ShowNull), "", "  ")
+	if err != nil {
+		return err
+	}
+
+	p.UI.DisplayText(string(raw))
+	return nil
+}
+
+// YAMLLabelPrinter renders labels as a YAML mapping.
+type YAMLLabelPrinter struct {
+	UI       command.UI
+	ShowNull bool
+}
+
+func (p YAMLLabelPrinter) Print(labels map[string]types.NullString) error {
+	raw, err := yaml.Marshal(labelValues(labels, p.ShowNull))
+	if err != nil {
+		return err
+	}
+
+	p.UI.DisplayText(string(raw))
+	return nil
+}
+
+func (p YAMLLabelPrinter) PrintList(resourceLabels []resources.LabelFields) error {
+	raw, err := yaml.Marshal(namedLabelValues(resourceLabels, p.ShowNull))
+	if err != nil {
+		return err
+	}
+
+	p.UI.DisplayText(string(raw))
+	return nil
+}
+
+func (p YAMLLabelPrinter) PrintGrouped(groups []LabelGroup) error {
+	raw, err := yaml.Marshal(groupedLabelValues(groups, p.ShowNull))
+	if err != nil {
+		return err
+	}
+
+	p.UI.DisplayText(string(raw))
+	return nil
+}
+
+// labelValues flattens a label map down to plain values suitable for
+// marshalling. Unset values are omitted unless showNull is true, in which
+// case they're rendered as an explicit null.
+func labelValues(labels map[string]types.NullString, showNull bool) map[string]interface{} {
+	values := make(map[string]interface{})
+	for key, value := range labels {
+		if !value.IsSet {
+			if showNull {
+				values[key] = nil
+			}
+			continue
+		}
+		values[key] = value.Value
+	}
+	return values
+}
+
+// namedLabelValue is a single labelled resource in JSON/YAML list/grouped
+// output. Labels nest under their own field so a label literally keyed
+// "name" can't collide with and overwrite the resource's own name.
+type namedLabelValue struct {
+	Name   string                 `json:"name" yaml:"name"`
+	Labels map[string]interface{} `json:"labels" yaml:"labels"`
+}
+
+// namedLabelValues flattens a list of labelled resources into a JSON/YAML
+// friendly slice of {name, labels} entries.
+func namedLabelValues(resourceLabels []resources.LabelFields, showNull bool) []namedLabelValue {
+	entries := make([]namedLabelValue, 0, len(resourceLabels))
+	for _, resource := range sortedByName(resourceLabels) {
+		entries = append(entries, namedLabelValue{
+			Name:   resource.Name,
+			Labels: labelValues(resource.Labels, showNull),
+		})
+	}
+	return entries
+}
+
+// groupedLabelValue is a single titled section in JSON/YAML grouped output,
+// e.g. the "spaces" or "apps" section of `cf labels org ORG -R`.
+type groupedLabelValue struct {
+	Title     string            `json:"title" yaml:"title"`
+	Resources []namedLabelValue `json:"resources" yaml:"resources"`
+}
+
+// groupedLabelValues flattens a recursive result into a JSON/YAML friendly
+// list of sections, preserving the group order PrintGrouped uses for table
+// output (a map would re-sort sections alphabetically by title).
+func groupedLabelValues(groups []LabelGroup, showNull bool) []groupedLabelValue {
+	values := make([]groupedLabelValue, 0, len(groups))
+	for _, group := range groups {
+		values = append(values, groupedLabelValue{
+			Title:     group.Title,
+			Resources: namedLabelValues(group.Resources, showNull),
+		})
+	}
+	return values
+}
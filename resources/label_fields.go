@@ -0,0 +1,12 @@
+package resources
+
+import "code.cloudfoundry.org/cli/types"
+
+// LabelFields is a named resource's GUID and labels, returned by bulk
+// label-listing endpoints that operate across many resources at once
+// (label-selector queries, org/space cascades).
+type LabelFields struct {
+	Name   string
+	GUID   string
+	Labels map[string]types.NullString
+}
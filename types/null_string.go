@@ -0,0 +1,46 @@
+// Package types holds small value types shared across the CLI's command and
+// actor layers; NullString in particular is relied on anywhere a label or
+// other optional API field needs to distinguish "empty" from "unset" (the
+// metadata/label commands, but not only them). Treat it as core
+// infrastructure the label feature depends on, not something label.go owns.
+package types
+
+import "encoding/json"
+
+// NullString is a string that can be explicitly null, distinguishing an
+// empty value from one the API never set.
+type NullString struct {
+	Value string
+	IsSet bool
+}
+
+// ParseValue sets the NullString using a raw value; an empty string is
+// treated as unset, matching the CC API's convention of omitting the key.
+func (n *NullString) ParseValue(value string) {
+	n.IsSet = value != ""
+	n.Value = value
+}
+
+func (n NullString) MarshalJSON() ([]byte, error) {
+	if !n.IsSet {
+		return json.Marshal(nil)
+	}
+	return json.Marshal(n.Value)
+}
+
+func (n *NullString) UnmarshalJSON(rawJSON []byte) error {
+	var value *string
+	if err := json.Unmarshal(rawJSON, &value); err != nil {
+		return err
+	}
+
+	if value == nil {
+		n.Value = ""
+		n.IsSet = false
+		return nil
+	}
+
+	n.Value = *value
+	n.IsSet = true
+	return nil
+}